@@ -0,0 +1,25 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// BindJSON decodes r's JSON body into dst and validates it against dst's
+// `validate` struct tags, the way Gin's ShouldBindJSON plus a validator
+// call would in one step. On success dst is populated and the returned
+// ValidationErrors is nil.
+func BindJSON(r *http.Request, dst interface{}) (ValidationErrors, error) {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return nil, err
+	}
+	return validate(dst), nil
+}
+
+// WriteValidationErrors writes errs as the standard {"errors":[...]} body
+// with HTTP 422 Unprocessable Entity.
+func WriteValidationErrors(w http.ResponseWriter, errs ValidationErrors) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	json.NewEncoder(w).Encode(validationErrorsResponse{Errors: errs})
+}
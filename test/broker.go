@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"sync"
+)
+
+// Event is the payload published for a user lifecycle change.
+type Event struct {
+	Topic string
+	User  *User
+}
+
+// Unsub cancels a subscription created by Broker.Subscribe.
+type Unsub func()
+
+// Broker decouples publishers of user lifecycle events (UserService) from
+// their consumers: the SSE endpoint, the sample stdout subscriber, and
+// gRPC streaming clients.
+type Broker interface {
+	Publish(topic string, evt Event) error
+	Subscribe(topic string, handler func(Event)) (Unsub, error)
+}
+
+// inProcessBroker is the default Broker: an in-memory pub/sub that fans
+// events out to subscribers on their own goroutine, with no external
+// dependency.
+type inProcessBroker struct {
+	mu          sync.Mutex
+	subscribers map[int]subscription
+	nextID      int
+}
+
+type subscription struct {
+	topic   string
+	handler func(Event)
+}
+
+// NewInProcessBroker creates a Broker that only delivers events within
+// this process.
+func NewInProcessBroker() Broker {
+	return &inProcessBroker{subscribers: make(map[int]subscription)}
+}
+
+// Publish fans evt out to every subscription whose topic pattern matches.
+func (b *inProcessBroker) Publish(topic string, evt Event) error {
+	evt.Topic = topic
+
+	b.mu.Lock()
+	handlers := make([]func(Event), 0, len(b.subscribers))
+	for _, sub := range b.subscribers {
+		if topicMatches(sub.topic, topic) {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, handler := range handlers {
+		go handler(evt)
+	}
+	return nil
+}
+
+// Subscribe registers handler for topic, which may be an exact topic
+// ("user.created") or end in ".*" to match every topic with exactly one
+// more token after the prefix ("user.*" matches "user.created" but not
+// "user.created.retry").
+func (b *inProcessBroker) Subscribe(topic string, handler func(Event)) (Unsub, error) {
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = subscription{topic: topic, handler: handler}
+	b.mu.Unlock()
+
+	return func() {
+		b.mu.Lock()
+		delete(b.subscribers, id)
+		b.mu.Unlock()
+	}, nil
+}
+
+// topicMatches reports whether topic satisfies pattern.
+func topicMatches(pattern, topic string) bool {
+	if pattern == topic {
+		return true
+	}
+	if strings.HasSuffix(pattern, ".*") {
+		prefix := strings.TrimSuffix(pattern, "*")
+		rest := strings.TrimPrefix(topic, prefix)
+		return rest != topic && !strings.Contains(rest, ".")
+	}
+	return false
+}
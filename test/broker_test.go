@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInProcessBrokerPublishSubscribe(t *testing.T) {
+	b := NewInProcessBroker()
+
+	received := make(chan Event, 1)
+	unsub, err := b.Subscribe("user.*", func(evt Event) {
+		received <- evt
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer unsub()
+
+	if err := b.Publish("user.created", Event{User: &User{ID: 1, Name: "Ada"}}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	select {
+	case evt := <-received:
+		if evt.Topic != "user.created" || evt.User.Name != "Ada" {
+			t.Fatalf("Subscribe handler got %+v, want topic user.created and user Ada", evt)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscriber never received the published event")
+	}
+}
+
+func TestInProcessBrokerUnsubStopsDelivery(t *testing.T) {
+	b := NewInProcessBroker()
+
+	received := make(chan Event, 1)
+	unsub, err := b.Subscribe("user.created", func(evt Event) {
+		received <- evt
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	unsub()
+
+	if err := b.Publish("user.created", Event{User: &User{ID: 1}}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	select {
+	case evt := <-received:
+		t.Fatalf("unsubscribed handler still received %+v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestTopicMatches(t *testing.T) {
+	cases := []struct {
+		pattern, topic string
+		want           bool
+	}{
+		{"user.created", "user.created", true},
+		{"user.created", "user.updated", false},
+		{"user.*", "user.created", true},
+		{"user.*", "user.updated", true},
+		{"user.*", "order.created", false},
+		{"user.*", "user.created.retry", false},
+	}
+	for _, c := range cases {
+		if got := topicMatches(c.pattern, c.topic); got != c.want {
+			t.Errorf("topicMatches(%q, %q) = %v, want %v", c.pattern, c.topic, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	gatewayruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/tkgalk/noconfetti/test/middleware"
+	userv1 "github.com/tkgalk/noconfetti/test/proto/user/v1"
+)
+
+const grpcPort = ":9090"
+
+// jwtSecret signs and verifies the bearer tokens middleware.Auth checks.
+// Real deployments should load this from config, not a source constant.
+var jwtSecret = []byte("change-me-in-production")
+
+// newServeMux builds the HTTP routing table shared by runServer and its
+// tests, forwarding /v1/ to gateway. It's split out from runServer so
+// tests can exercise the route registrations (and catch net/http.ServeMux
+// pattern conflicts) without binding any real ports.
+func newServeMux(service *UserService, gateway http.Handler) *http.ServeMux {
+	handler := NewHandler(service)
+	mux := http.NewServeMux()
+
+	base := middleware.Chain(
+		middleware.RequestID,
+		middleware.AccessLog(log.Default()),
+		middleware.RateLimit(10, 20),
+	)
+	admin := middleware.Chain(
+		middleware.RequestID,
+		middleware.AccessLog(log.Default()),
+		middleware.RateLimit(10, 20),
+		middleware.Auth(jwtSecret),
+		middleware.RequireRole("admin"),
+	)
+
+	mux.Handle("GET /users", base(http.HandlerFunc(handler.GetUsers)))
+	mux.Handle("POST /users", base(http.HandlerFunc(handler.PostUser)))
+	mux.Handle("PUT /users/{id}", base(http.HandlerFunc(handler.PutUser)))
+	mux.Handle("DELETE /users/{id}", admin(http.HandlerFunc(handler.DeleteUser)))
+	// Scoped to GET: net/http.ServeMux treats an unscoped "/users/events"
+	// as matching every method, which conflicts with the more specific
+	// "PUT /users/{id}" pattern above and panics at registration time.
+	mux.Handle("GET /users/events", NewEventsHandler(service.broker))
+	mux.Handle("/v1/", gateway)
+
+	return mux
+}
+
+// runServer starts the existing net/http mux alongside a gRPC server on
+// grpcPort, with grpc-gateway exposing the same RPCs as /v1/users REST
+// endpoints. This is the cmd/server entry point; main leaves it commented
+// out so `go run test/...` still runs the plain demo by default.
+//
+// The gRPC server only talks to clients built against userv1 (see the
+// KNOWN GAP note on that package) — it is not interoperable with a stock
+// grpc-go or Go-Micro client.
+func runServer(service *UserService) error {
+	grpcServer := grpc.NewServer(userv1.ServerCodecOption())
+	userv1.RegisterUserServiceServer(grpcServer, newGRPCServer(service))
+
+	lis, err := net.Listen("tcp", grpcPort)
+	if err != nil {
+		return err
+	}
+	go func() {
+		log.Printf("Starting gRPC server on %s\n", grpcPort)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Printf("grpc server stopped: %v\n", err)
+		}
+	}()
+
+	ctx := context.Background()
+	gwMux := gatewayruntime.NewServeMux()
+	err = userv1.RegisterUserServiceHandlerFromEndpoint(ctx, gwMux, "localhost"+grpcPort,
+		[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), userv1.ClientCodecOption()})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Starting HTTP server on %s\n", Port)
+	return http.ListenAndServe(Port, newServeMux(service, gwMux))
+}
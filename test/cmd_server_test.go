@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNewServeMuxRegistersWithoutPanic guards against net/http.ServeMux
+// pattern conflicts between the routes runServer wires up (e.g. an
+// unscoped "/users/events" previously panicked against the more specific
+// "PUT /users/{id}") going unnoticed because runServer is never called in
+// tests.
+func TestNewServeMuxRegistersWithoutPanic(t *testing.T) {
+	service := NewUserService(NewUserRepository(), NewInProcessBroker())
+	mux := newServeMux(service, http.NotFoundHandler())
+
+	// EventsHandler streams until its request context is done, so cancel
+	// up front to make this a route-matching check, not a hang.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/users/events", nil).WithContext(ctx)
+	mux.ServeHTTP(rec, req)
+	if rec.Code == http.StatusNotFound {
+		t.Fatalf("GET /users/events did not match its route")
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// deadlineTimer enforces a maximum operation deadline independently of the
+// caller's context, modeled on the netstack deadlineTimer: a cancel channel
+// that is closed exactly once when the deadline fires, so any number of
+// goroutines can select on it without racing.
+type deadlineTimer struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	done  chan struct{}
+}
+
+// newDeadlineTimer starts a timer that closes its done channel after d.
+func newDeadlineTimer(d time.Duration) *deadlineTimer {
+	dt := &deadlineTimer{done: make(chan struct{})}
+	dt.timer = time.AfterFunc(d, dt.expire)
+	return dt
+}
+
+func (dt *deadlineTimer) expire() {
+	dt.mu.Lock()
+	defer dt.mu.Unlock()
+	select {
+	case <-dt.done:
+	default:
+		close(dt.done)
+	}
+}
+
+// stop cancels the pending expiry. Safe to call after expiry has fired.
+func (dt *deadlineTimer) stop() {
+	dt.timer.Stop()
+}
+
+// C returns the channel that closes once the deadline fires.
+func (dt *deadlineTimer) C() <-chan struct{} {
+	return dt.done
+}
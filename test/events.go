@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// logSubscriberToStdout is the sample subscriber: it logs every user
+// lifecycle event to stdout. Useful as a template for a real consumer.
+func logSubscriberToStdout(broker Broker) {
+	broker.Subscribe("user.*", func(evt Event) {
+		fmt.Printf("[%s] %s\n", evt.Topic, evt.User)
+	})
+}
+
+// EventsHandler streams user lifecycle events to browsers over
+// server-sent events.
+type EventsHandler struct {
+	broker Broker
+}
+
+// NewEventsHandler wraps broker for use as an http.Handler at
+// /users/events.
+func NewEventsHandler(broker Broker) *EventsHandler {
+	return &EventsHandler{broker: broker}
+}
+
+// ServeHTTP streams TopicUserCreated/Updated/Deleted events as SSE
+// messages until the client disconnects.
+func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	events := make(chan Event, 16)
+	unsub, err := h.broker.Subscribe("user.*", func(evt Event) {
+		select {
+		case events <- evt:
+		default:
+			// Drop the event rather than block publishers on a slow client.
+		}
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer unsub()
+
+	for {
+		select {
+		case evt := <-events:
+			data, err := json.Marshal(evt.User)
+			if err != nil {
+				log.Printf("events: marshal %s: %v\n", evt.Topic, err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", evt.Topic, data)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
@@ -4,7 +4,9 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -23,10 +25,10 @@ const (
 // User represents a user entity
 type User struct {
 	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Age       int       `json:"age"`
-	Role      string    `json:"role"`
+	Name      string    `json:"name" validate:"required"`
+	Email     string    `json:"email" validate:"required,email"`
+	Age       int       `json:"age" validate:"gte=0,lte=150"`
+	Role      string    `json:"role" validate:"required"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -51,12 +53,20 @@ func (u *User) String() string {
 	return fmt.Sprintf("User{id=%d, name=%s, age=%d}", u.ID, u.Name, u.Age)
 }
 
-// Repository interface for data access
+// ErrUserNotFound is wrapped by every Repository implementation's "not
+// found" errors, so callers can distinguish a missing user from other
+// failures with errors.Is instead of matching error strings.
+var ErrUserNotFound = errors.New("user not found")
+
+// Repository interface for data access. Every method takes a context so
+// callers can cancel or time out a request and have that cancellation
+// observed by the underlying storage, instead of the request leaking past
+// its deadline.
 type Repository interface {
-	Find(id int) (*User, error)
-	FindAll() ([]*User, error)
-	Save(user *User) (*User, error)
-	Delete(id int) error
+	Find(ctx context.Context, id int) (*User, error)
+	FindAll(ctx context.Context) ([]*User, error)
+	Save(ctx context.Context, user *User) (*User, error)
+	Delete(ctx context.Context, id int) error
 }
 
 // UserRepository implements Repository with in-memory storage
@@ -75,31 +85,45 @@ func NewUserRepository() *UserRepository {
 }
 
 // Find returns user by ID
-func (r *UserRepository) Find(id int) (*User, error) {
+func (r *UserRepository) Find(ctx context.Context, id int) (*User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	user, exists := r.users[id]
 	if !exists {
-		return nil, fmt.Errorf("user not found: %d", id)
+		return nil, fmt.Errorf("%w: %d", ErrUserNotFound, id)
 	}
 	return user, nil
 }
 
-// FindAll returns all users
-func (r *UserRepository) FindAll() ([]*User, error) {
+// FindAll returns all users, aborting the scan as soon as ctx is done so a
+// canceled request doesn't hold the read lock over a large map.
+func (r *UserRepository) FindAll(ctx context.Context) ([]*User, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	users := make([]*User, 0, len(r.users))
 	for _, user := range r.users {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
 		users = append(users, user)
 	}
 	return users, nil
 }
 
 // Save adds or updates a user
-func (r *UserRepository) Save(user *User) (*User, error) {
+func (r *UserRepository) Save(ctx context.Context, user *User) (*User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -112,24 +136,33 @@ func (r *UserRepository) Save(user *User) (*User, error) {
 }
 
 // Delete removes a user by ID
-func (r *UserRepository) Delete(id int) error {
+func (r *UserRepository) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if _, exists := r.users[id]; !exists {
-		return fmt.Errorf("user not found: %d", id)
+		return fmt.Errorf("%w: %d", ErrUserNotFound, id)
 	}
 	delete(r.users, id)
 	return nil
 }
 
 // FindByRole returns users with specific role
-func (r *UserRepository) FindByRole(role string) ([]*User, error) {
+func (r *UserRepository) FindByRole(ctx context.Context, role string) ([]*User, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	users := make([]*User, 0)
 	for _, user := range r.users {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
 		if user.Role == role {
 			users = append(users, user)
 		}
@@ -137,29 +170,81 @@ func (r *UserRepository) FindByRole(role string) ([]*User, error) {
 	return users, nil
 }
 
+// Topics published by UserService for user lifecycle changes.
+const (
+	TopicUserCreated = "user.created"
+	TopicUserUpdated = "user.updated"
+	TopicUserDeleted = "user.deleted"
+)
+
 // UserService handles business logic
 type UserService struct {
-	repo Repository
+	repo   Repository
+	broker Broker
 }
 
 // NewUserService creates a new service
-func NewUserService(repo Repository) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo Repository, broker Broker) *UserService {
+	return &UserService{repo: repo, broker: broker}
 }
 
-// CreateUser creates a new user
-func (s *UserService) CreateUser(name, email string, age int, role string) (*User, error) {
-	if err := validateAge(age); err != nil {
+// CreateUser validates and creates a new user, publishing TopicUserCreated
+func (s *UserService) CreateUser(ctx context.Context, name, email string, age int, role string) (*User, error) {
+	user := NewUser(name, email, age, role)
+	if errs := validate(user); errs != nil {
+		return nil, errs
+	}
+
+	saved, err := s.repo.Save(ctx, user)
+	if err != nil {
 		return nil, err
 	}
 
-	user := NewUser(name, email, age, role)
-	return s.repo.Save(user)
+	s.publish(TopicUserCreated, saved)
+	return saved, nil
+}
+
+// Save validates and creates or updates a user, publishing TopicUserUpdated
+func (s *UserService) Save(ctx context.Context, user *User) (*User, error) {
+	if errs := validate(user); errs != nil {
+		return nil, errs
+	}
+
+	saved, err := s.repo.Save(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(TopicUserUpdated, saved)
+	return saved, nil
+}
+
+// Delete removes a user by ID and publishes TopicUserDeleted
+func (s *UserService) Delete(ctx context.Context, id int) error {
+	user, err := s.repo.Find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.publish(TopicUserDeleted, user)
+	return nil
+}
+
+func (s *UserService) publish(topic string, user *User) {
+	if s.broker == nil {
+		return
+	}
+	if err := s.broker.Publish(topic, Event{User: user}); err != nil {
+		log.Printf("publish %s: %v\n", topic, err)
+	}
 }
 
 // GetAdultUsers returns all adult users
-func (s *UserService) GetAdultUsers() ([]*User, error) {
-	users, err := s.repo.FindAll()
+func (s *UserService) GetAdultUsers(ctx context.Context) ([]*User, error) {
+	users, err := s.repo.FindAll(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -174,8 +259,8 @@ func (s *UserService) GetAdultUsers() ([]*User, error) {
 }
 
 // GetAverageAge calculates average user age
-func (s *UserService) GetAverageAge() (float64, error) {
-	users, err := s.repo.FindAll()
+func (s *UserService) GetAverageAge(ctx context.Context) (float64, error) {
+	users, err := s.repo.FindAll(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -192,13 +277,6 @@ func (s *UserService) GetAverageAge() (float64, error) {
 	return float64(totalAge) / float64(len(users)), nil
 }
 
-func validateAge(age int) error {
-	if age < 0 || age > 150 {
-		return fmt.Errorf("age must be between 0 and 150")
-	}
-	return nil
-}
-
 // HTTP handlers
 type Handler struct {
 	service *UserService
@@ -209,7 +287,10 @@ func NewHandler(service *UserService) *Handler {
 }
 
 func (h *Handler) GetUsers(w http.ResponseWriter, r *http.Request) {
-	users, err := h.service.repo.FindAll()
+	ctx, cancel := context.WithTimeout(r.Context(), Timeout)
+	defer cancel()
+
+	users, err := h.service.repo.FindAll(ctx)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -220,7 +301,10 @@ func (h *Handler) GetUsers(w http.ResponseWriter, r *http.Request) {
 }
 
 // Goroutine examples
-func processUsersConcurrently(users []*User) {
+func processUsersConcurrently(ctx context.Context, users []*User) {
+	dt := newDeadlineTimer(Timeout)
+	defer dt.stop()
+
 	var wg sync.WaitGroup
 	results := make(chan string, len(users))
 
@@ -228,9 +312,19 @@ func processUsersConcurrently(users []*User) {
 		wg.Add(1)
 		go func(u *User) {
 			defer wg.Done()
-			// Simulate processing
-			time.Sleep(100 * time.Millisecond)
-			results <- fmt.Sprintf("Processed: %s", u.Name)
+			select {
+			case <-time.After(100 * time.Millisecond):
+				// Simulate processing
+			case <-ctx.Done():
+				return
+			case <-dt.C():
+				return
+			}
+			select {
+			case results <- fmt.Sprintf("Processed: %s", u.Name):
+			case <-ctx.Done():
+			case <-dt.C():
+			}
 		}(user)
 	}
 
@@ -240,9 +334,19 @@ func processUsersConcurrently(users []*User) {
 		close(results)
 	}()
 
-	// Collect results
-	for result := range results {
-		fmt.Println(result)
+	// Collect results, aborting early on cancellation or the Timeout deadline
+	for {
+		select {
+		case result, ok := <-results:
+			if !ok {
+				return
+			}
+			fmt.Println(result)
+		case <-ctx.Done():
+			return
+		case <-dt.C():
+			return
+		}
 	}
 }
 
@@ -329,29 +433,29 @@ func demonstrateDefer() {
 	// Output: Start, End, Deferred 2, Deferred 1
 }
 
-// Error handling
-type ValidationError struct {
-	Field string
-	Error string
-}
-
-func (e *ValidationError) Error() string {
-	return fmt.Sprintf("%s: %s", e.Field, e.Error)
-}
-
 // Main function
 func main() {
 	fmt.Println("Go User Management System")
 	fmt.Println("API Version:", APIVersion)
 
-	// Create repository and service
-	repo := NewUserRepository()
-	service := NewUserService(repo)
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+
+	// Create repository, broker, and service. RepoConfig lets operators
+	// switch storage backends (memory/sql/redis) without touching
+	// UserService; the demo below only needs "memory".
+	repo, err := NewRepository(RepoConfig{Driver: "memory"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	broker := NewInProcessBroker()
+	service := NewUserService(repo, broker)
+	logSubscriberToStdout(broker)
 
 	// Create users
-	alice, _ := service.CreateUser("Alice Johnson", "alice@example.com", 28, "admin")
-	bob, _ := service.CreateUser("Bob Smith", "bob@example.com", 17, "user")
-	charlie, _ := service.CreateUser("Charlie Brown", "charlie@example.com", 45, "user")
+	alice, _ := service.CreateUser(ctx, "Alice Johnson", "alice@example.com", 28, "admin")
+	bob, _ := service.CreateUser(ctx, "Bob Smith", "bob@example.com", 17, "user")
+	charlie, _ := service.CreateUser(ctx, "Charlie Brown", "charlie@example.com", 45, "user")
 
 	fmt.Println("\nCreated users:")
 	fmt.Println(alice)
@@ -359,19 +463,19 @@ func main() {
 	fmt.Println(charlie)
 
 	// Get adult users
-	adults, _ := service.GetAdultUsers()
+	adults, _ := service.GetAdultUsers(ctx)
 	fmt.Printf("\nAdult users: %d\n", len(adults))
 	for _, user := range adults {
 		fmt.Printf("  %s (%d)\n", user.Name, user.Age)
 	}
 
 	// Average age
-	avgAge, _ := service.GetAverageAge()
+	avgAge, _ := service.GetAverageAge(ctx)
 	fmt.Printf("\nAverage age: %.2f\n", avgAge)
 
 	// Goroutine example
 	fmt.Println("\nProcessing users concurrently:")
-	processUsersConcurrently([]*User{alice, bob, charlie})
+	processUsersConcurrently(ctx, []*User{alice, bob, charlie})
 
 	// Demonstrations
 	fmt.Println("\nSlice demonstration:")
@@ -383,9 +487,6 @@ func main() {
 	fmt.Println("\nDefer demonstration:")
 	demonstrateDefer()
 
-	// Start HTTP server (commented out)
-	// handler := NewHandler(service)
-	// http.HandleFunc("/users", handler.GetUsers)
-	// log.Printf("Starting server on %s\n", Port)
-	// log.Fatal(http.ListenAndServe(Port, nil))
+	// Start HTTP + gRPC servers (commented out)
+	// log.Fatal(runServer(service))
 }
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	userv1 "github.com/tkgalk/noconfetti/test/proto/user/v1"
+)
+
+// grpcServer adapts UserService to the generated UserServiceServer
+// interface, so the HTTP handlers and the gRPC service share one
+// implementation of the business logic.
+type grpcServer struct {
+	userv1.UnimplementedUserServiceServer
+	service *UserService
+}
+
+// newGRPCServer wraps service for use with userv1.RegisterUserServiceServer.
+func newGRPCServer(service *UserService) *grpcServer {
+	return &grpcServer{service: service}
+}
+
+func (s *grpcServer) CreateUser(ctx context.Context, req *userv1.CreateUserRequest) (*userv1.User, error) {
+	user, err := s.service.CreateUser(ctx, req.Name, req.Email, int(req.Age), req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoUser(user), nil
+}
+
+func (s *grpcServer) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.User, error) {
+	user, err := s.service.repo.Find(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return toProtoUser(user), nil
+}
+
+func (s *grpcServer) ListUsers(ctx context.Context, req *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error) {
+	users, err := s.service.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.ListUsersResponse{Users: toProtoUsers(users)}, nil
+}
+
+func (s *grpcServer) DeleteUser(ctx context.Context, req *userv1.DeleteUserRequest) (*userv1.DeleteUserResponse, error) {
+	if err := s.service.Delete(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &userv1.DeleteUserResponse{}, nil
+}
+
+func (s *grpcServer) FindByRole(ctx context.Context, req *userv1.FindByRoleRequest) (*userv1.FindByRoleResponse, error) {
+	byRole, ok := s.service.repo.(interface {
+		FindByRole(ctx context.Context, role string) ([]*User, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("grpc server: repository does not support FindByRole")
+	}
+	users, err := byRole.FindByRole(ctx, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.FindByRoleResponse{Users: toProtoUsers(users)}, nil
+}
+
+// StreamUserEvents forwards every user.* lifecycle event to the client
+// until it disconnects.
+func (s *grpcServer) StreamUserEvents(req *userv1.StreamUserEventsRequest, stream userv1.UserService_StreamUserEventsServer) error {
+	if s.service.broker == nil {
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	}
+
+	events := make(chan Event, 16)
+	unsub, err := s.service.broker.Subscribe("user.*", func(evt Event) {
+		select {
+		case events <- evt:
+		default:
+		}
+	})
+	if err != nil {
+		return err
+	}
+	defer unsub()
+
+	for {
+		select {
+		case evt := <-events:
+			if err := stream.Send(&userv1.UserEvent{Topic: evt.Topic, User: toProtoUser(evt.User)}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func toProtoUser(u *User) *userv1.User {
+	return &userv1.User{
+		Id:        int32(u.ID),
+		Name:      u.Name,
+		Email:     u.Email,
+		Age:       int32(u.Age),
+		Role:      u.Role,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func toProtoUsers(users []*User) []*userv1.User {
+	out := make([]*userv1.User, 0, len(users))
+	for _, u := range users {
+		out = append(out, toProtoUser(u))
+	}
+	return out
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// CreateUserRequest is the JSON body accepted by POST /users and
+// PUT /users/{id}.
+type CreateUserRequest struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+	Age   int    `json:"age" validate:"gte=0,lte=150"`
+	Role  string `json:"role" validate:"required"`
+}
+
+// PostUser handles POST /users.
+func (h *Handler) PostUser(w http.ResponseWriter, r *http.Request) {
+	var req CreateUserRequest
+	errs, err := BindJSON(r, &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errs != nil {
+		WriteValidationErrors(w, errs)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), Timeout)
+	defer cancel()
+
+	user, err := h.service.CreateUser(ctx, req.Name, req.Email, req.Age, req.Role)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(user)
+}
+
+// PutUser handles PUT /users/{id}.
+func (h *Handler) PutUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid user id", http.StatusBadRequest)
+		return
+	}
+
+	var req CreateUserRequest
+	errs, err := BindJSON(r, &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errs != nil {
+		WriteValidationErrors(w, errs)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), Timeout)
+	defer cancel()
+
+	existing, err := h.service.repo.Find(ctx, id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	user := &User{
+		ID:        id,
+		Name:      req.Name,
+		Email:     req.Email,
+		Age:       req.Age,
+		Role:      req.Role,
+		CreatedAt: existing.CreatedAt,
+	}
+
+	saved, err := h.service.Save(ctx, user)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(saved)
+}
+
+// DeleteUser handles DELETE /users/{id}. It is wired up behind
+// middleware.RequireRole("admin") since deleting a user is destructive.
+func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid user id", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), Timeout)
+	defer cancel()
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeServiceError writes a 422 with field-level detail for a
+// ValidationErrors, a 404 for ErrUserNotFound, or a plain 500 for
+// anything else.
+func writeServiceError(w http.ResponseWriter, err error) {
+	if errs, ok := err.(ValidationErrors); ok {
+		WriteValidationErrors(w, errs)
+		return
+	}
+	if errors.Is(err, ErrUserNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func newTestHandler() *Handler {
+	return NewHandler(NewUserService(NewUserRepository(), NewInProcessBroker()))
+}
+
+func TestPutUserMissingIDReturns404(t *testing.T) {
+	h := newTestHandler()
+
+	body, _ := json.Marshal(CreateUserRequest{Name: "Ada", Email: "ada@example.com", Age: 30, Role: "admin"})
+	req := httptest.NewRequest(http.MethodPut, "/users/99999", bytes.NewReader(body))
+	req.SetPathValue("id", "99999")
+	rec := httptest.NewRecorder()
+
+	h.PutUser(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("PutUser on a missing id = %d, want %d (body %q)", rec.Code, http.StatusNotFound, rec.Body.String())
+	}
+}
+
+func TestPutUserExistingPreservesCreatedAt(t *testing.T) {
+	h := newTestHandler()
+
+	created, err := h.service.CreateUser(context.Background(), "Grace", "grace@example.com", 40, "engineer")
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	body, _ := json.Marshal(CreateUserRequest{Name: "Grace H.", Email: "grace@example.com", Age: 41, Role: "engineer"})
+	req := httptest.NewRequest(http.MethodPut, "/users/1", bytes.NewReader(body))
+	req.SetPathValue("id", strconv.Itoa(created.ID))
+	rec := httptest.NewRecorder()
+
+	h.PutUser(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("PutUser on an existing id = %d, want 200 (body %q)", rec.Code, rec.Body.String())
+	}
+	var saved User
+	if err := json.Unmarshal(rec.Body.Bytes(), &saved); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !saved.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("PutUser changed CreatedAt: got %v, want %v", saved.CreatedAt, created.CreatedAt)
+	}
+	if saved.Name != "Grace H." {
+		t.Fatalf("PutUser did not apply the update: got name %q", saved.Name)
+	}
+}
+
+func TestDeleteUserMissingIDReturns404(t *testing.T) {
+	h := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodDelete, "/users/99999", nil)
+	req.SetPathValue("id", "99999")
+	rec := httptest.NewRecorder()
+
+	h.DeleteUser(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("DeleteUser on a missing id = %d, want %d (body %q)", rec.Code, http.StatusNotFound, rec.Body.String())
+	}
+}
@@ -0,0 +1,9 @@
+package main
+
+import "testing"
+
+func TestUserRepositoryConformance(t *testing.T) {
+	testRepositoryConformance(t, func(t *testing.T) Repository {
+		return NewUserRepository()
+	})
+}
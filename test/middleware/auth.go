@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// AuthUser is the claims payload carried by the JWT, populated into the
+// request context so handlers can call CurrentUser.
+type AuthUser struct {
+	ID   int    `json:"id"`
+	Role string `json:"role"`
+}
+
+type userContextKey struct{}
+
+// Auth validates the request's "Authorization: Bearer <token>" header as
+// an HS256 JWT signed with secret, and populates the decoded AuthUser
+// into the request context.
+func Auth(secret []byte) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if token == "" {
+				http.Error(w, "missing bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			user, err := parseJWT(token, secret)
+			if err != nil {
+				http.Error(w, "invalid token: "+err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), userContextKey{}, user)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// CurrentUser returns the AuthUser populated by Auth, or nil if the
+// request was never authenticated.
+func CurrentUser(r *http.Request) *AuthUser {
+	user, _ := r.Context().Value(userContextKey{}).(*AuthUser)
+	return user
+}
+
+// RequireRole returns middleware that responds 403 Forbidden unless
+// CurrentUser has the given role.
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user := CurrentUser(r)
+			if user == nil || user.Role != role {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// parseJWT verifies token's HS256 signature against secret and decodes
+// its claims into an AuthUser.
+func parseJWT(token string, secret []byte) (*AuthUser, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	expected := mac.Sum(nil)
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed signature")
+	}
+	if !hmac.Equal(sig, expected) {
+		return nil, fmt.Errorf("signature mismatch")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed payload")
+	}
+
+	var claims AuthUser
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed claims: %w", err)
+	}
+	return &claims, nil
+}
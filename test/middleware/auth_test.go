@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func signToken(secret []byte, claims AuthUser) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payloadBytes, _ := json.Marshal(claims)
+	payload := base64.RawURLEncoding.EncodeToString(payloadBytes)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(header + "." + payload))
+	sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+
+	return header + "." + payload + "." + sig
+}
+
+func TestParseJWTRoundTrip(t *testing.T) {
+	secret := []byte("test-secret")
+	want := AuthUser{ID: 7, Role: "admin"}
+	token := signToken(secret, want)
+
+	got, err := parseJWT(token, secret)
+	if err != nil {
+		t.Fatalf("parseJWT: %v", err)
+	}
+	if *got != want {
+		t.Fatalf("parseJWT = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseJWTRejectsBadSignature(t *testing.T) {
+	token := signToken([]byte("test-secret"), AuthUser{ID: 1, Role: "user"})
+	if _, err := parseJWT(token, []byte("wrong-secret")); err == nil {
+		t.Fatal("parseJWT with the wrong secret = nil error, want a signature mismatch")
+	}
+}
+
+func TestParseJWTRejectsMalformedToken(t *testing.T) {
+	if _, err := parseJWT("not-a-jwt", []byte("secret")); err == nil {
+		t.Fatal("parseJWT on a malformed token = nil error, want an error")
+	}
+}
+
+func TestRequireRoleForbidsWrongRole(t *testing.T) {
+	handler := RequireRole("admin")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx := context.WithValue(req.Context(), userContextKey{}, &AuthUser{ID: 1, Role: "user"})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req.WithContext(ctx))
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("RequireRole(admin) with role=user = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestRequireRoleAllowsMatchingRole(t *testing.T) {
+	handler := RequireRole("admin")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx := context.WithValue(req.Context(), userContextKey{}, &AuthUser{ID: 1, Role: "admin"})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req.WithContext(ctx))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("RequireRole(admin) with role=admin = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestRequireRoleForbidsUnauthenticated(t *testing.T) {
+	handler := RequireRole("admin")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("RequireRole(admin) with no authenticated user = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,78 @@
+// Package middleware provides composable func(http.Handler) http.Handler
+// decorators for request ID injection, access logging, rate limiting, and
+// JWT authentication, so handlers stay focused on business logic.
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"time"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// Chain composes decorators so Chain(a, b, c)(h) applies a(b(c(h))) - a
+// runs first, c closest to the handler.
+func Chain(decorators ...func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		for i := len(decorators) - 1; i >= 0; i-- {
+			h = decorators[i](h)
+		}
+		return h
+	}
+}
+
+// RequestID injects a unique ID into the request context and the
+// X-Request-ID response header.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := newRequestID()
+		w.Header().Set("X-Request-ID", id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey, id)))
+	})
+}
+
+// RequestIDFromContext returns the ID set by RequestID, or "" if absent.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// statusRecorder captures the status code written by the wrapped handler
+// so AccessLog can report it after ServeHTTP returns.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// AccessLog logs method, path, status, and duration for every request
+// that passes through it.
+func AccessLog(logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			logger.Printf("method=%s path=%s status=%d duration=%s request_id=%s",
+				r.Method, r.URL.Path, rec.status, time.Since(start), RequestIDFromContext(r.Context()))
+		})
+	}
+}
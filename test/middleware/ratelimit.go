@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenBucket tracks the remaining request allowance for a single remote
+// IP, refilled continuously at rps tokens per second up to burst.
+type tokenBucket struct {
+	mu       sync.Mutex
+	tokens   float64
+	lastSeen time.Time
+}
+
+func (b *tokenBucket) allow(rps float64, burst int) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.lastSeen).Seconds() * rps
+	if b.tokens > float64(burst) {
+		b.tokens = float64(burst)
+	}
+	b.lastSeen = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// RateLimit returns middleware that allows up to rps requests per second
+// per remote IP, with bursts of up to burst requests.
+func RateLimit(rps float64, burst int) func(http.Handler) http.Handler {
+	var mu sync.Mutex
+	buckets := make(map[string]*tokenBucket)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := clientIP(r)
+
+			mu.Lock()
+			b, ok := buckets[ip]
+			if !ok {
+				b = &tokenBucket{tokens: float64(burst), lastSeen: time.Now()}
+				buckets[ip] = b
+			}
+			mu.Unlock()
+
+			if !b.allow(rps, burst) {
+				http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
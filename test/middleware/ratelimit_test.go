@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucketAllowsUpToBurstThenDenies(t *testing.T) {
+	b := &tokenBucket{}
+
+	if !b.allow(1, 2) {
+		t.Fatal("first allow() on a fresh bucket = false, want true")
+	}
+	if !b.allow(1, 2) {
+		t.Fatal("second allow() within burst = false, want true")
+	}
+	if b.allow(1, 2) {
+		t.Fatal("third immediate allow() beyond burst = true, want false")
+	}
+}
+
+func TestTokenBucketRefillsOverTime(t *testing.T) {
+	b := &tokenBucket{tokens: 0, lastSeen: time.Now().Add(-time.Second)}
+
+	if !b.allow(1, 2) {
+		t.Fatal("allow() after a second has passed at 1rps = false, want true")
+	}
+}
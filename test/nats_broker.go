@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsBroker implements Broker on top of a NATS connection, for
+// deployments that need user events to fan out across multiple processes
+// rather than staying within one.
+type natsBroker struct {
+	conn *nats.Conn
+}
+
+// NewNATSBroker connects to the NATS server at url.
+func NewNATSBroker(url string) (Broker, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("nats broker: connect: %w", err)
+	}
+	return &natsBroker{conn: conn}, nil
+}
+
+func (b *natsBroker) Publish(topic string, evt Event) error {
+	evt.Topic = topic
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("nats broker: marshal: %w", err)
+	}
+	return b.conn.Publish(topic, data)
+}
+
+func (b *natsBroker) Subscribe(topic string, handler func(Event)) (Unsub, error) {
+	sub, err := b.conn.Subscribe(natsSubject(topic), func(msg *nats.Msg) {
+		var evt Event
+		if err := json.Unmarshal(msg.Data, &evt); err != nil {
+			return
+		}
+		handler(evt)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("nats broker: subscribe: %w", err)
+	}
+	return func() { sub.Unsubscribe() }, nil
+}
+
+// natsSubject rewrites a dotted "user.*" pattern to the NATS subject
+// "user.>", which matches any number of remaining tokens. Unlike the
+// in-process broker's topicMatches, this broker therefore also delivers
+// deeper topics such as "user.created.retry" to a "user.*" subscriber.
+func natsSubject(topic string) string {
+	if strings.HasSuffix(topic, ".*") {
+		return strings.TrimSuffix(topic, "*") + ">"
+	}
+	return topic
+}
@@ -0,0 +1,39 @@
+package userv1
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc"
+)
+
+// jsonCodec marshals RPC messages as JSON instead of the protobuf wire
+// format, since the types in this package aren't proto.Message (see the
+// user_pb.go package comment for why). ServerCodecOption and
+// ClientCodecOption wire it into the grpc.Server and grpc.ClientConn used
+// for this service; they must be used together, on both ends of the
+// connection, for the service to be able to decode anything at all.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+// ServerCodecOption forces grpc.NewServer to encode and decode this
+// service's messages with jsonCodec.
+func ServerCodecOption() grpc.ServerOption {
+	return grpc.ForceServerCodec(jsonCodec{})
+}
+
+// ClientCodecOption forces a grpc.ClientConn's calls to encode and decode
+// this service's messages with jsonCodec.
+func ClientCodecOption() grpc.DialOption {
+	return grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{}))
+}
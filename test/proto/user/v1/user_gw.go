@@ -0,0 +1,90 @@
+// Hand-written REST bridge mirroring the google.api.http annotations in
+// user.proto. A real protoc-gen-grpc-gateway run would generate this file
+// (and use runtime.ForwardResponseMessage, which requires proto.Message
+// responses); since this package's messages aren't proto.Message (see
+// user_pb.go) it forwards responses with plain encoding/json instead.
+package userv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// RegisterUserServiceHandlerFromEndpoint registers the UserService handlers
+// on mux, dialing endpoint with opts to reach the gRPC server.
+func RegisterUserServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	return RegisterUserServiceHandler(ctx, mux, conn)
+}
+
+// RegisterUserServiceHandler registers the UserService handlers on mux,
+// forwarding REST requests to the given gRPC connection.
+func RegisterUserServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	client := NewUserServiceClient(conn)
+
+	mux.HandlePath("POST", "/v1/users", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req CreateUserRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := client.CreateUser(r.Context(), &req)
+		forwardJSON(w, resp, err)
+	})
+
+	mux.HandlePath("GET", "/v1/users", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		resp, err := client.ListUsers(r.Context(), &ListUsersRequest{})
+		forwardJSON(w, resp, err)
+	})
+
+	mux.HandlePath("GET", "/v1/users/by-role/{role}", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		resp, err := client.FindByRole(r.Context(), &FindByRoleRequest{Role: pathParams["role"]})
+		forwardJSON(w, resp, err)
+	})
+
+	mux.HandlePath("GET", "/v1/users/{id}", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		id, err := strconv.Atoi(pathParams["id"])
+		if err != nil {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return
+		}
+		resp, err := client.GetUser(r.Context(), &GetUserRequest{Id: int32(id)})
+		forwardJSON(w, resp, err)
+	})
+
+	mux.HandlePath("DELETE", "/v1/users/{id}", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		id, err := strconv.Atoi(pathParams["id"])
+		if err != nil {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return
+		}
+		resp, err := client.DeleteUser(r.Context(), &DeleteUserRequest{Id: int32(id)})
+		forwardJSON(w, resp, err)
+	})
+
+	return nil
+}
+
+// forwardJSON writes resp as the JSON response body, or translates a gRPC
+// error into the matching HTTP status and a {"error": "..."} body if the
+// call failed.
+func forwardJSON(w http.ResponseWriter, resp any, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		st := status.Convert(err)
+		w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+		json.NewEncoder(w).Encode(map[string]string{"error": st.Message()})
+		return
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,271 @@
+// Package userv1 holds the Go types for proto/user/v1/user.proto. This
+// module has no protoc toolchain available to it, so unlike a normal
+// protoc-gen-go/protoc-gen-go-grpc pair these types are hand-written rather
+// than generated, and they don't implement proto.Message. So that
+// google.golang.org/grpc can carry them without protobuf encoding,
+// CreateUser/GetUser/ListUsers/DeleteUser/FindByRole/
+// StreamUserEvents are dispatched over a small JSON Codec (see codec.go)
+// instead of the default protobuf one. Regenerate this file by hand if
+// user.proto changes; there is no `make proto` target.
+//
+// KNOWN GAP: this only talks to other clients/servers built against this
+// package and its ForceCodec/ForceServerCodec options. It does NOT speak
+// the protobuf wire format, so a stock grpc-go or Go-Micro client dialing
+// this service with its default codec cannot call it — the cross-runtime
+// interop that motivated adding a gRPC surface in the first place isn't
+// actually delivered here. Flag this to whoever asked for gRPC/Go-Micro
+// interop before relying on it; closing the gap for real means running
+// genuine protoc/protoc-gen-go-grpc generation once this module has a
+// protoc toolchain available.
+package userv1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// User mirrors the proto User message.
+type User struct {
+	Id        int32
+	Name      string
+	Email     string
+	Age       int32
+	Role      string
+	CreatedAt time.Time
+}
+
+type CreateUserRequest struct {
+	Name  string
+	Email string
+	Age   int32
+	Role  string
+}
+
+type GetUserRequest struct{ Id int32 }
+
+type ListUsersRequest struct{}
+
+type ListUsersResponse struct{ Users []*User }
+
+type DeleteUserRequest struct{ Id int32 }
+
+type DeleteUserResponse struct{}
+
+type FindByRoleRequest struct{ Role string }
+
+type FindByRoleResponse struct{ Users []*User }
+
+type StreamUserEventsRequest struct{}
+
+type UserEvent struct {
+	Topic string
+	User  *User
+}
+
+// UserServiceServer is the server API for UserService.
+type UserServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	FindByRole(context.Context, *FindByRoleRequest) (*FindByRoleResponse, error)
+	StreamUserEvents(*StreamUserEventsRequest, UserService_StreamUserEventsServer) error
+}
+
+// UserService_StreamUserEventsServer is the server-streaming RPC stream for
+// StreamUserEvents.
+type UserService_StreamUserEventsServer interface {
+	Send(*UserEvent) error
+	grpc.ServerStream
+}
+
+// UnimplementedUserServiceServer must be embedded by server implementations
+// to stay source-compatible as new RPCs are added to the service.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) FindByRole(context.Context, *FindByRoleRequest) (*FindByRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByRole not implemented")
+}
+func (UnimplementedUserServiceServer) StreamUserEvents(*StreamUserEventsRequest, UserService_StreamUserEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUserEvents not implemented")
+}
+
+// RegisterUserServiceServer registers srv with s.
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+// UserServiceClient is the client API for UserService.
+type UserServiceClient interface {
+	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	GetUser(ctx context.Context, req *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	FindByRole(ctx context.Context, req *FindByRoleRequest, opts ...grpc.CallOption) (*FindByRoleResponse, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewUserServiceClient builds a UserServiceClient backed by cc.
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc: cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, req *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	resp := &User{}
+	err := c.cc.Invoke(ctx, "/user.v1.UserService/CreateUser", req, resp, opts...)
+	return resp, err
+}
+
+func (c *userServiceClient) GetUser(ctx context.Context, req *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	resp := &User{}
+	err := c.cc.Invoke(ctx, "/user.v1.UserService/GetUser", req, resp, opts...)
+	return resp, err
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, req *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	resp := &ListUsersResponse{}
+	err := c.cc.Invoke(ctx, "/user.v1.UserService/ListUsers", req, resp, opts...)
+	return resp, err
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	resp := &DeleteUserResponse{}
+	err := c.cc.Invoke(ctx, "/user.v1.UserService/DeleteUser", req, resp, opts...)
+	return resp, err
+}
+
+func (c *userServiceClient) FindByRole(ctx context.Context, req *FindByRoleRequest, opts ...grpc.CallOption) (*FindByRoleResponse, error) {
+	resp := &FindByRoleResponse{}
+	err := c.cc.Invoke(ctx, "/user.v1.UserService/FindByRole", req, resp, opts...)
+	return resp, err
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/user.v1.UserService/CreateUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/user.v1.UserService/GetUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/user.v1.UserService/ListUsers"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/user.v1.UserService/DeleteUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FindByRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FindByRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/user.v1.UserService/FindByRole"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FindByRole(ctx, req.(*FindByRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StreamUserEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUserEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).StreamUserEvents(m, &userServiceStreamUserEventsServer{stream})
+}
+
+type userServiceStreamUserEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceStreamUserEventsServer) Send(m *UserEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "CreateUser", Handler: _UserService_CreateUser_Handler},
+		{MethodName: "GetUser", Handler: _UserService_GetUser_Handler},
+		{MethodName: "ListUsers", Handler: _UserService_ListUsers_Handler},
+		{MethodName: "DeleteUser", Handler: _UserService_DeleteUser_Handler},
+		{MethodName: "FindByRole", Handler: _UserService_FindByRole_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUserEvents",
+			Handler:       _UserService_StreamUserEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "user/v1/user.proto",
+}
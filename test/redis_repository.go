@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisUserKeyPrefix = "user:"
+	redisRoleKeyPrefix = "role:"
+	redisNextIDKey     = "user:next_id"
+)
+
+// RedisRepository implements Repository by storing each user as a hash
+// (user:<id>) and indexing roles as a set per role (role:<role>) for
+// FindByRole.
+type RedisRepository struct {
+	client *redis.Client
+}
+
+// NewRedisRepository connects to the Redis instance at addr.
+func NewRedisRepository(addr string) (*RedisRepository, error) {
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("redis repository: ping: %w", err)
+	}
+	return &RedisRepository{client: client}, nil
+}
+
+func userKey(id int) string      { return redisUserKeyPrefix + strconv.Itoa(id) }
+func roleKey(role string) string { return redisRoleKeyPrefix + role }
+
+// Find returns user by ID
+func (r *RedisRepository) Find(ctx context.Context, id int) (*User, error) {
+	fields, err := r.client.HGetAll(ctx, userKey(id)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis repository: find: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrUserNotFound, id)
+	}
+	return userFromFields(id, fields)
+}
+
+// FindAll returns all users
+func (r *RedisRepository) FindAll(ctx context.Context) ([]*User, error) {
+	keys, err := r.client.Keys(ctx, redisUserKeyPrefix+"*").Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis repository: find all: %w", err)
+	}
+
+	users := make([]*User, 0, len(keys))
+	for _, key := range keys {
+		if key == redisNextIDKey {
+			continue
+		}
+		id, err := strconv.Atoi(key[len(redisUserKeyPrefix):])
+		if err != nil {
+			continue
+		}
+		user, err := r.Find(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// Save adds or updates a user
+func (r *RedisRepository) Save(ctx context.Context, user *User) (*User, error) {
+	if user.ID == 0 {
+		id, err := r.client.Incr(ctx, redisNextIDKey).Result()
+		if err != nil {
+			return nil, fmt.Errorf("redis repository: next id: %w", err)
+		}
+		user.ID = int(id)
+	} else if old, err := r.Find(ctx, user.ID); err == nil && old.Role != user.Role {
+		r.client.SRem(ctx, roleKey(old.Role), user.ID)
+	}
+
+	fields := map[string]interface{}{
+		"name":       user.Name,
+		"email":      user.Email,
+		"age":        user.Age,
+		"role":       user.Role,
+		"created_at": user.CreatedAt.Format(time.RFC3339),
+	}
+	if err := r.client.HSet(ctx, userKey(user.ID), fields).Err(); err != nil {
+		return nil, fmt.Errorf("redis repository: save: %w", err)
+	}
+	if err := r.client.SAdd(ctx, roleKey(user.Role), user.ID).Err(); err != nil {
+		return nil, fmt.Errorf("redis repository: index role: %w", err)
+	}
+	return user, nil
+}
+
+// Delete removes a user by ID
+func (r *RedisRepository) Delete(ctx context.Context, id int) error {
+	user, err := r.Find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.client.Del(ctx, userKey(id)).Err(); err != nil {
+		return fmt.Errorf("redis repository: delete: %w", err)
+	}
+	return r.client.SRem(ctx, roleKey(user.Role), id).Err()
+}
+
+// FindByRole returns users with specific role, via the role:<role> set
+func (r *RedisRepository) FindByRole(ctx context.Context, role string) ([]*User, error) {
+	ids, err := r.client.SMembers(ctx, roleKey(role)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis repository: find by role: %w", err)
+	}
+
+	users := make([]*User, 0, len(ids))
+	for _, idStr := range ids {
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			continue
+		}
+		user, err := r.Find(ctx, id)
+		if err != nil {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func userFromFields(id int, fields map[string]string) (*User, error) {
+	age, err := strconv.Atoi(fields["age"])
+	if err != nil {
+		return nil, fmt.Errorf("redis repository: parse age: %w", err)
+	}
+	createdAt, err := time.Parse(time.RFC3339, fields["created_at"])
+	if err != nil {
+		return nil, fmt.Errorf("redis repository: parse created_at: %w", err)
+	}
+	return &User{
+		ID:        id,
+		Name:      fields["name"],
+		Email:     fields["email"],
+		Age:       age,
+		Role:      fields["role"],
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+// TestRedisRepositoryConformance requires a Redis instance reachable at
+// redisTestAddr; it skips rather than failing when one isn't available,
+// since CI/dev environments don't all run Redis.
+const redisTestAddr = "localhost:6379"
+
+func TestRedisRepositoryConformance(t *testing.T) {
+	repo, err := NewRedisRepository(redisTestAddr)
+	if err != nil {
+		t.Skipf("no redis reachable at %s: %v", redisTestAddr, err)
+	}
+
+	testRepositoryConformance(t, func(t *testing.T) Repository {
+		return repo
+	})
+}
@@ -0,0 +1,24 @@
+package main
+
+import "fmt"
+
+// RepoConfig selects and configures a Repository implementation at startup.
+type RepoConfig struct {
+	Driver string // "memory", "sql", or "redis"
+	DSN    string // driver-specific connection string, unused for "memory"
+}
+
+// NewRepository builds the Repository selected by cfg so operators can
+// switch storage backends without touching UserService.
+func NewRepository(cfg RepoConfig) (Repository, error) {
+	switch cfg.Driver {
+	case "", "memory":
+		return NewUserRepository(), nil
+	case "sql":
+		return NewSQLRepository(cfg.DSN)
+	case "redis":
+		return NewRedisRepository(cfg.DSN)
+	default:
+		return nil, fmt.Errorf("unknown repository driver: %s", cfg.Driver)
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+// testRepositoryConformance runs the same behavioral checks against any
+// Repository implementation, so memory/SQL/Redis backends are all held to
+// the same contract instead of only the in-memory one being covered.
+func testRepositoryConformance(t *testing.T, newRepo func(t *testing.T) Repository) {
+	t.Run("SaveAssignsID", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		saved, err := repo.Save(ctx, &User{Name: "Ada", Email: "ada@example.com", Age: 30, Role: "admin"})
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		if saved.ID == 0 {
+			t.Fatalf("Save did not assign an ID: %+v", saved)
+		}
+	})
+
+	t.Run("FindRoundTrips", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		saved, err := repo.Save(ctx, &User{Name: "Grace", Email: "grace@example.com", Age: 40, Role: "engineer"})
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		got, err := repo.Find(ctx, saved.ID)
+		if err != nil {
+			t.Fatalf("Find: %v", err)
+		}
+		if got.Name != saved.Name || got.Email != saved.Email || got.Role != saved.Role {
+			t.Fatalf("Find returned %+v, want %+v", got, saved)
+		}
+	})
+
+	t.Run("FindMissingErrors", func(t *testing.T) {
+		repo := newRepo(t)
+		if _, err := repo.Find(context.Background(), 999999); err == nil {
+			t.Fatal("Find on a missing ID should error")
+		}
+	})
+
+	t.Run("SaveUpdatesExisting", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		saved, err := repo.Save(ctx, &User{Name: "Linus", Email: "linus@example.com", Age: 25, Role: "engineer"})
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		saved.Role = "admin"
+		if _, err := repo.Save(ctx, saved); err != nil {
+			t.Fatalf("Save (update): %v", err)
+		}
+
+		got, err := repo.Find(ctx, saved.ID)
+		if err != nil {
+			t.Fatalf("Find: %v", err)
+		}
+		if got.Role != "admin" {
+			t.Fatalf("Save did not persist the update: got role %q", got.Role)
+		}
+	})
+
+	t.Run("FindAllIncludesSaved", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		saved, err := repo.Save(ctx, &User{Name: "Margaret", Email: "margaret@example.com", Age: 45, Role: "admin"})
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		all, err := repo.FindAll(ctx)
+		if err != nil {
+			t.Fatalf("FindAll: %v", err)
+		}
+		if !containsID(all, saved.ID) {
+			t.Fatalf("FindAll %+v does not contain saved user %d", all, saved.ID)
+		}
+	})
+
+	t.Run("DeleteRemovesUser", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		saved, err := repo.Save(ctx, &User{Name: "Katherine", Email: "katherine@example.com", Age: 50, Role: "engineer"})
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		if err := repo.Delete(ctx, saved.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := repo.Find(ctx, saved.ID); err == nil {
+			t.Fatal("Find should error for a deleted user")
+		}
+	})
+
+	t.Run("DeleteMissingErrors", func(t *testing.T) {
+		repo := newRepo(t)
+		if err := repo.Delete(context.Background(), 999999); err == nil {
+			t.Fatal("Delete on a missing ID should error")
+		}
+	})
+
+	t.Run("FindByRoleFiltersByRole", func(t *testing.T) {
+		repo := newRepo(t)
+		byRole, ok := repo.(interface {
+			FindByRole(ctx context.Context, role string) ([]*User, error)
+		})
+		if !ok {
+			t.Skip("repository does not implement FindByRole")
+		}
+		ctx := context.Background()
+
+		admin, err := repo.Save(ctx, &User{Name: "Alan", Email: "alan@example.com", Age: 35, Role: "admin"})
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		if _, err := repo.Save(ctx, &User{Name: "Barbara", Email: "barbara@example.com", Age: 28, Role: "engineer"}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		admins, err := byRole.FindByRole(ctx, "admin")
+		if err != nil {
+			t.Fatalf("FindByRole: %v", err)
+		}
+		if !containsID(admins, admin.ID) {
+			t.Fatalf("FindByRole(%q) = %+v, want it to contain %d", "admin", admins, admin.ID)
+		}
+		for _, u := range admins {
+			if u.Role != "admin" {
+				t.Fatalf("FindByRole(%q) returned a %q user: %+v", "admin", u.Role, u)
+			}
+		}
+	})
+}
+
+func containsID(users []*User, id int) bool {
+	for _, u := range users {
+		if u.ID == id {
+			return true
+		}
+	}
+	return false
+}
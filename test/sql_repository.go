@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	// Side-effect imports register the "postgres" and "sqlite3" drivers
+	// with database/sql; the DSN scheme picks which one SQLRepository uses.
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// createUsersTableSQLite relies on SQLite's INTEGER PRIMARY KEY aliasing
+// the rowid, which auto-assigns ids on insert.
+const createUsersTableSQLite = `
+CREATE TABLE IF NOT EXISTS users (
+	id         INTEGER PRIMARY KEY,
+	name       TEXT NOT NULL,
+	email      TEXT NOT NULL,
+	age        INTEGER NOT NULL,
+	role       TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+`
+
+// createUsersTablePostgres uses SERIAL instead, since a plain Postgres
+// INTEGER PRIMARY KEY has no default sequence and would reject every
+// insert that doesn't specify an id.
+const createUsersTablePostgres = `
+CREATE TABLE IF NOT EXISTS users (
+	id         SERIAL PRIMARY KEY,
+	name       TEXT NOT NULL,
+	email      TEXT NOT NULL,
+	age        INTEGER NOT NULL,
+	role       TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+`
+
+// SQLRepository implements Repository on top of database/sql. A DSN
+// starting with postgres:// or postgresql:// uses the "postgres" driver;
+// any other DSN is passed unchanged to the "sqlite3" driver.
+type SQLRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+// NewSQLRepository opens dsn and runs the users-table migration.
+func NewSQLRepository(dsn string) (*SQLRepository, error) {
+	driver := sqlDriverFor(dsn)
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql repository: open %s: %w", driver, err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("sql repository: ping: %w", err)
+	}
+
+	repo := &SQLRepository{db: db, driver: driver}
+	if err := repo.migrate(context.Background()); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func sqlDriverFor(dsn string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		return "postgres"
+	}
+	return "sqlite3"
+}
+
+// ph returns the nth (1-indexed) bind placeholder for r's driver: lib/pq
+// requires positional "$1, $2, ..." placeholders, everything else here
+// uses "?".
+func (r *SQLRepository) ph(n int) string {
+	if r.driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (r *SQLRepository) migrate(ctx context.Context) error {
+	schema := createUsersTableSQLite
+	if r.driver == "postgres" {
+		schema = createUsersTablePostgres
+	}
+	if _, err := r.db.ExecContext(ctx, schema); err != nil {
+		return fmt.Errorf("sql repository: migrate: %w", err)
+	}
+	return nil
+}
+
+// Find returns user by ID
+func (r *SQLRepository) Find(ctx context.Context, id int) (*User, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf(`SELECT id, name, email, age, role, created_at FROM users WHERE id = %s`, r.ph(1)), id)
+
+	user := &User{}
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.Role, &user.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: %d", ErrUserNotFound, id)
+		}
+		return nil, fmt.Errorf("sql repository: find: %w", err)
+	}
+	return user, nil
+}
+
+// FindAll returns all users
+func (r *SQLRepository) FindAll(ctx context.Context) ([]*User, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name, email, age, role, created_at FROM users`)
+	if err != nil {
+		return nil, fmt.Errorf("sql repository: find all: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0)
+	for rows.Next() {
+		user := &User{}
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.Role, &user.CreatedAt); err != nil {
+			return nil, fmt.Errorf("sql repository: scan: %w", err)
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+// Save adds or updates a user
+func (r *SQLRepository) Save(ctx context.Context, user *User) (*User, error) {
+	if user.ID == 0 {
+		insert := fmt.Sprintf(`INSERT INTO users (name, email, age, role, created_at) VALUES (%s, %s, %s, %s, %s)`,
+			r.ph(1), r.ph(2), r.ph(3), r.ph(4), r.ph(5))
+
+		// lib/pq doesn't implement LastInsertId (Postgres has no generic
+		// equivalent); RETURNING id is the idiomatic way to get it back.
+		if r.driver == "postgres" {
+			row := r.db.QueryRowContext(ctx, insert+" RETURNING id",
+				user.Name, user.Email, user.Age, user.Role, user.CreatedAt)
+			if err := row.Scan(&user.ID); err != nil {
+				return nil, fmt.Errorf("sql repository: insert: %w", err)
+			}
+			return user, nil
+		}
+
+		res, err := r.db.ExecContext(ctx, insert, user.Name, user.Email, user.Age, user.Role, user.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("sql repository: insert: %w", err)
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("sql repository: last insert id: %w", err)
+		}
+		user.ID = int(id)
+		return user, nil
+	}
+
+	update := fmt.Sprintf(`UPDATE users SET name = %s, email = %s, age = %s, role = %s, created_at = %s WHERE id = %s`,
+		r.ph(1), r.ph(2), r.ph(3), r.ph(4), r.ph(5), r.ph(6))
+	if _, err := r.db.ExecContext(ctx, update,
+		user.Name, user.Email, user.Age, user.Role, user.CreatedAt, user.ID); err != nil {
+		return nil, fmt.Errorf("sql repository: update: %w", err)
+	}
+	return user, nil
+}
+
+// Delete removes a user by ID
+func (r *SQLRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM users WHERE id = %s`, r.ph(1)), id)
+	if err != nil {
+		return fmt.Errorf("sql repository: delete: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sql repository: rows affected: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %d", ErrUserNotFound, id)
+	}
+	return nil
+}
+
+// FindByRole returns users with specific role, using the idx_users_role index
+func (r *SQLRepository) FindByRole(ctx context.Context, role string) ([]*User, error) {
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`SELECT id, name, email, age, role, created_at FROM users WHERE role = %s`, r.ph(1)), role)
+	if err != nil {
+		return nil, fmt.Errorf("sql repository: find by role: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0)
+	for rows.Next() {
+		user := &User{}
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Age, &user.Role, &user.CreatedAt); err != nil {
+			return nil, fmt.Errorf("sql repository: scan: %w", err)
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
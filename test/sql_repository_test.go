@@ -0,0 +1,18 @@
+package main
+
+import "testing"
+
+// Each subtest opens its own in-memory sqlite3 database via a unique DSN,
+// so runs don't see each other's data (sqlite3's "file::memory:" DSN
+// without "cache=shared" gives each *sql.DB connection its own database,
+// but SQLRepository's single *sql.DB would otherwise share one database
+// across subtests if the DSN were fixed).
+func TestSQLRepositoryConformance(t *testing.T) {
+	testRepositoryConformance(t, func(t *testing.T) Repository {
+		repo, err := NewSQLRepository("file:" + t.Name() + "?mode=memory&cache=shared")
+		if err != nil {
+			t.Fatalf("NewSQLRepository: %v", err)
+		}
+		return repo
+	})
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FieldError describes a single struct field that failed validation.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors collects every FieldError from a failed validation. It
+// implements error so it can be returned anywhere a plain error is
+// expected, e.g. from UserService.CreateUser.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// validationErrorsResponse is the JSON body written for a failed
+// validation: {"errors":[{"field":...,"code":...,"message":"..."}]}.
+type validationErrorsResponse struct {
+	Errors ValidationErrors `json:"errors"`
+}
+
+// validate runs every `validate:"..."` struct tag rule against v's fields
+// and returns the accumulated ValidationErrors, or nil if v is valid.
+func validate(v interface{}) ValidationErrors {
+	var errs ValidationErrors
+
+	val := reflect.ValueOf(v)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	typ := val.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("validate")
+		if tag == "" {
+			continue
+		}
+
+		name := jsonFieldName(field)
+		for _, rule := range strings.Split(tag, ",") {
+			if fe := applyRule(name, val.Field(i), rule); fe != nil {
+				errs = append(errs, *fe)
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "" || tag == "-" {
+		return field.Name
+	}
+	return strings.Split(tag, ",")[0]
+}
+
+func applyRule(field string, v reflect.Value, rule string) *FieldError {
+	name, param, _ := strings.Cut(rule, "=")
+
+	switch name {
+	case "required":
+		if v.IsZero() {
+			return &FieldError{Field: field, Code: "required", Message: fmt.Sprintf("%s is required", field)}
+		}
+	case "email":
+		if s := v.String(); s != "" {
+			if _, err := mail.ParseAddress(s); err != nil {
+				return &FieldError{Field: field, Code: "invalid_email", Message: fmt.Sprintf("%s must be a valid email address", field)}
+			}
+		}
+	case "gte":
+		min, _ := strconv.ParseFloat(param, 64)
+		if numericValue(v) < min {
+			return &FieldError{Field: field, Code: "too_small", Message: fmt.Sprintf("%s must be >= %s", field, param)}
+		}
+	case "lte":
+		max, _ := strconv.ParseFloat(param, 64)
+		if numericValue(v) > max {
+			return &FieldError{Field: field, Code: "too_large", Message: fmt.Sprintf("%s must be <= %s", field, param)}
+		}
+	}
+	return nil
+}
+
+func numericValue(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateValidUserReturnsNil(t *testing.T) {
+	user := NewUser("Ada Lovelace", "ada@example.com", 30, "admin")
+	if errs := validate(user); errs != nil {
+		t.Fatalf("validate(valid user) = %v, want nil", errs)
+	}
+}
+
+func TestValidateReportsEveryFailingField(t *testing.T) {
+	user := &User{Name: "", Email: "not-an-email", Age: 200, Role: "admin"}
+	errs := validate(user)
+	if errs == nil {
+		t.Fatal("validate(invalid user) = nil, want errors")
+	}
+
+	byField := make(map[string]FieldError)
+	for _, fe := range errs {
+		byField[fe.Field] = fe
+	}
+
+	if fe, ok := byField["name"]; !ok || fe.Code != "required" {
+		t.Errorf("name field error = %+v, want code required", fe)
+	}
+	if fe, ok := byField["email"]; !ok || fe.Code != "invalid_email" {
+		t.Errorf("email field error = %+v, want code invalid_email", fe)
+	}
+	if fe, ok := byField["age"]; !ok || fe.Code != "too_large" {
+		t.Errorf("age field error = %+v, want code too_large", fe)
+	}
+}
+
+func TestApplyRuleRequired(t *testing.T) {
+	if fe := applyRule("name", reflect.ValueOf(""), "required"); fe == nil {
+		t.Error("applyRule(required) on empty string = nil, want an error")
+	}
+	if fe := applyRule("name", reflect.ValueOf("Ada"), "required"); fe != nil {
+		t.Errorf("applyRule(required) on non-empty string = %+v, want nil", fe)
+	}
+}
+
+func TestApplyRuleEmail(t *testing.T) {
+	if fe := applyRule("email", reflect.ValueOf("not-an-email"), "email"); fe == nil {
+		t.Error("applyRule(email) on an invalid address = nil, want an error")
+	}
+	if fe := applyRule("email", reflect.ValueOf("ada@example.com"), "email"); fe != nil {
+		t.Errorf("applyRule(email) on a valid address = %+v, want nil", fe)
+	}
+}
+
+func TestApplyRuleGteLte(t *testing.T) {
+	if fe := applyRule("age", reflect.ValueOf(-1), "gte=0"); fe == nil {
+		t.Error("applyRule(gte=0) on -1 = nil, want an error")
+	}
+	if fe := applyRule("age", reflect.ValueOf(200), "lte=150"); fe == nil {
+		t.Error("applyRule(lte=150) on 200 = nil, want an error")
+	}
+	if fe := applyRule("age", reflect.ValueOf(30), "gte=0"); fe != nil {
+		t.Errorf("applyRule(gte=0) on 30 = %+v, want nil", fe)
+	}
+	if fe := applyRule("age", reflect.ValueOf(30), "lte=150"); fe != nil {
+		t.Errorf("applyRule(lte=150) on 30 = %+v, want nil", fe)
+	}
+}